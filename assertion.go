@@ -70,6 +70,30 @@ type Asserter struct {
 	// If set to nil, default behavior for formatting failure messages is
 	// performed, the specifics of which vary depending on the assertion.
 	Format func(varName string, expected interface{}, actual interface{}) string
+
+	// Differ, when set, is consulted by Equal, DeepEqual, and EqualSlices on
+	// failure. Its report is appended to the failure message, which makes it
+	// possible to get a rich, path-annotated diff instead of just the raw
+	// expected/actual values. See the cmpdiff sub-package for a
+	// go-cmp-backed implementation.
+	Differ Differ
+
+	// failHook, when non-nil, is called with the formatted failure message
+	// instead of reporting it to t. It is used internally by EventuallyWithT
+	// and NeverWithT to poll a condition without a failure on any single
+	// iteration being reported to the real *testing.T.
+	failHook func(msg string)
+}
+
+// Differ produces a rich, human-readable report of the difference between
+// an expected and actual value. Implementations are free to return an empty
+// report if they have nothing useful to add; equal is informational only and
+// is not used by Asserter to decide whether a comparison passed or failed.
+//
+// See the cmpdiff sub-package for an implementation backed by
+// github.com/google/go-cmp/cmp.
+type Differ interface {
+	Diff(expected, actual interface{}) (equal bool, report string)
 }
 
 // New creates a new Asserter that fails the provided testing.T when an
@@ -103,17 +127,29 @@ func (a Asserter) Equal(expected, actual interface{}) {
 	if !argsEqual {
 		eVerb := fmtVerbForArg(expected)
 		aVerb := fmtVerbForArg(actual)
-		a.fail("expected %s to be "+eVerb+" but was "+aVerb, a.fullVar(), expected, actual)
+		format := "expected %s to be " + eVerb + " but was " + aVerb
+		var extra []interface{}
+		if diff := a.diffReport(expected, actual); diff != "" {
+			format += "\n%s"
+			extra = append(extra, diff)
+		}
+		a.fail(format, a.fullVar(), expected, actual, extra...)
 	}
 }
 
 // DeepEqual checks that the actual and expected values are deeply-equal by
 // calling reflect.DeepEqual on the two arguments.
 func (a Asserter) DeepEqual(expected, actual interface{}) {
-	if reflect.DeepEqual(actual, expected) {
+	if !reflect.DeepEqual(actual, expected) {
 		eVerb := fmtVerbForArg(expected)
 		aVerb := fmtVerbForArg(actual)
-		a.fail("expected %s to be "+eVerb+" but was "+aVerb, a.fullVar(), expected, actual)
+		format := "expected %s to be " + eVerb + " but was " + aVerb
+		var extra []interface{}
+		if diff := a.diffReport(expected, actual); diff != "" {
+			format += "\n%s"
+			extra = append(extra, diff)
+		}
+		a.fail(format, a.fullVar(), expected, actual, extra...)
 	}
 }
 
@@ -197,9 +233,15 @@ func (a Asserter) EqualSlicesFunc(expected, actual interface{}, elemComp func(ex
 		}
 
 		if !eq {
-			eVerb := fmtVerbForArg(eVal)
-			aVerb := fmtVerbForArg(aVal)
-			a.fail("expected %s to be "+eVerb+" but was "+aVerb, varName, eVal, aVal)
+			eVerb := fmtVerbForArg(eItem)
+			aVerb := fmtVerbForArg(aItem)
+			format := "expected %s to be " + eVerb + " but was " + aVerb
+			var extra []interface{}
+			if diff := a.diffReport(eItem, aItem); diff != "" {
+				format += "\n%s"
+				extra = append(extra, diff)
+			}
+			a.fail(format, varName, eItem, aItem, extra...)
 		}
 	}
 }
@@ -295,6 +337,16 @@ func fmtVerbForArg(value interface{}) string {
 	return "%v"
 }
 
+// diffReport returns the report produced by Differ for expected and actual,
+// or the empty string if no Differ is set or it has nothing to report.
+func (a Asserter) diffReport(expected, actual interface{}) string {
+	if a.Differ == nil {
+		return ""
+	}
+	_, report := a.Differ.Diff(expected, actual)
+	return report
+}
+
 // varName is full varName as it will be shown.
 //
 // expected or actual can be set to skipArg in which case they will not be
@@ -330,6 +382,11 @@ func (a Asserter) fail(format string, varName string, expected interface{}, actu
 		failureMsg = a.addCallerInfo(fmt.Sprintf(format, fmtArgs...))
 	}
 
+	if a.failHook != nil {
+		a.failHook(failureMsg)
+		return
+	}
+
 	if a.NonFatal {
 		a.t.Errorf(failureMsg)
 	} else {
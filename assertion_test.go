@@ -0,0 +1,32 @@
+package assertion
+
+import "testing"
+
+func TestDeepEqual(t *testing.T) {
+	type nested struct {
+		Tags []string
+	}
+
+	testCases := []struct {
+		name       string
+		expected   interface{}
+		actual     interface{}
+		expectFail bool
+	}{
+		{"equal structs", nested{Tags: []string{"a", "b"}}, nested{Tags: []string{"a", "b"}}, false},
+		{"differing structs", nested{Tags: []string{"a", "b"}}, nested{Tags: []string{"a", "c"}}, true},
+		{"equal slices", []int{1, 2, 3}, []int{1, 2, 3}, false},
+		{"differing slices", []int{1, 2, 3}, []int{1, 2, 4}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			failed, _ := captureFailure(func(a *Asserter) {
+				a.DeepEqual(tc.expected, tc.actual)
+			})
+			if failed != tc.expectFail {
+				t.Fatalf("expected failed=%v but was %v", tc.expectFail, failed)
+			}
+		})
+	}
+}
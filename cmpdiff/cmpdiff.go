@@ -0,0 +1,60 @@
+// Package cmpdiff provides an assertion.Differ implementation backed by
+// github.com/google/go-cmp/cmp. It is kept as a separate sub-package so that
+// the core assertion package has no dependency on go-cmp; only callers that
+// import cmpdiff pull it in.
+package cmpdiff
+
+import (
+	"fmt"
+
+	"github.com/dekarrin/assertion"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Comparer is an assertion.Differ that reports differences using cmp.Diff,
+// passing along any cmp.Options it was created with (transformers, custom
+// cmp.Comparers, cmpopts.IgnoreUnexported, approximate-float comparisons,
+// etc).
+type Comparer struct {
+	Options []cmp.Option
+}
+
+// New creates a Comparer that will use opts whenever it is asked to diff two
+// values.
+func New(opts ...cmp.Option) *Comparer {
+	return &Comparer{Options: opts}
+}
+
+// Diff reports whether expected and actual are equal according to cmp.Equal,
+// and if they are not, a multi-line, path-annotated report produced by
+// cmp.Diff.
+//
+// cmp panics on things it cannot safely compare, such as structs with
+// unexported fields and no matching cmpopts.IgnoreUnexported/exporter
+// option. Since Diff only ever supplements an Equal/DeepEqual/EqualSlices
+// failure that has already been determined some other way, such a panic is
+// recovered and reported as a fallback "(could not compute diff: ...)"
+// string rather than crashing the test binary.
+func (c *Comparer) Diff(expected, actual interface{}) (equal bool, report string) {
+	defer func() {
+		if r := recover(); r != nil {
+			equal = false
+			report = fmt.Sprintf("(could not compute diff: %v)", r)
+		}
+	}()
+
+	if cmp.Equal(expected, actual, c.Options...) {
+		return true, ""
+	}
+	return false, cmp.Diff(expected, actual, c.Options...)
+}
+
+// WithOptions sets a's Differ to a Comparer using opts and returns a so that
+// it can be chained with other Asserter methods, e.g.:
+//
+//     assert := cmpdiff.WithOptions(assertion.New(t), cmpopts.IgnoreUnexported(MyType{}))
+//     assert.Equal(expected, actual)
+func WithOptions(a *assertion.Asserter, opts ...cmp.Option) *assertion.Asserter {
+	a.Differ = New(opts...)
+	return a
+}
@@ -0,0 +1,51 @@
+package cmpdiff
+
+import (
+	"testing"
+
+	"github.com/dekarrin/assertion"
+)
+
+func TestComparer_Diff(t *testing.T) {
+	c := New()
+
+	eq, report := c.Diff(1, 1)
+	if !eq {
+		t.Fatal("expected 1 and 1 to be equal")
+	}
+	if report != "" {
+		t.Fatalf("expected empty report for equal values but got %q", report)
+	}
+
+	eq, report = c.Diff(1, 2)
+	if eq {
+		t.Fatal("expected 1 and 2 to not be equal")
+	}
+	if report == "" {
+		t.Fatal("expected a non-empty diff report for unequal values")
+	}
+}
+
+func TestComparer_Diff_UnexportedFieldDoesNotPanic(t *testing.T) {
+	type s struct {
+		Name string
+		age  int
+	}
+
+	c := New()
+
+	eq, report := c.Diff(s{Name: "a", age: 1}, s{Name: "a", age: 2})
+	if eq {
+		t.Fatal("expected values differing only in an unexported field to be reported as not equal")
+	}
+	if report == "" {
+		t.Fatal("expected a fallback report instead of a panic")
+	}
+}
+
+func TestWithOptions(t *testing.T) {
+	a := WithOptions(assertion.New(t))
+	if a.Differ == nil {
+		t.Fatal("expected WithOptions to set a Differ on the Asserter")
+	}
+}
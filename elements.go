@@ -0,0 +1,174 @@
+package assertion
+
+import "reflect"
+
+// EqualElements checks whether expected and actual are two slice-like (slice
+// or array) objects containing the same multiset of elements, regardless of
+// order. This is the unordered counterpart to EqualSlices.
+func (a Asserter) EqualElements(expected, actual interface{}) {
+	a.EqualElementsFunc(expected, actual, nil)
+}
+
+// EqualElementsFunc checks whether expected and actual are two slice-like
+// (slice or array) objects containing the same multiset of elements,
+// regardless of order.
+//
+// The elements are compared using the provided comp function which returns
+// whether the two elements passed to it are equal, exactly as
+// EqualSlicesFunc does.
+func (a Asserter) EqualElementsFunc(expected, actual interface{}, elemComp func(expected interface{}, actual interface{}) bool) {
+	eType := reflect.TypeOf(expected)
+	aType := reflect.TypeOf(actual)
+
+	// assert that both are slicey
+	if eType.Kind() != reflect.Slice && eType.Kind() != reflect.Array {
+		a.fail("%s: expected is not a slice", a.fullVar(), skipArg{expected}, skipArg{actual})
+		return
+	}
+	if aType.Kind() != reflect.Slice && aType.Kind() != reflect.Array {
+		a.fail("expected %s to be %v but actual value was not a slice", a.fullVar(), expected, skipArg{actual})
+		return
+	}
+
+	// assert that both are of the same type
+	if eType.Elem() != aType.Elem() {
+		a.fail("expected %s to have type %q but was %q", a.fullVar(), eType.Elem().Name(), aType.Elem().Name)
+		return
+	}
+
+	var eVal, aVal = reflect.ValueOf(expected), reflect.ValueOf(actual)
+	// Do nil check
+	aIsNil := aType.Kind() == reflect.Slice && aVal.IsNil()
+	eIsNil := eType.Kind() == reflect.Slice && eVal.IsNil()
+	if aIsNil && !eIsNil {
+		a.fail("expected %s to be %v but was a nil slice", a.fullVar(), expected, skipArg{actual})
+	}
+	if !aIsNil && eIsNil {
+		a.fail("expected %s to be a nil slice but was %v", a.fullVar(), skipArg{expected}, actual)
+	}
+	if aIsNil && eIsNil {
+		// nothing else to do, they are both nil slices of the same type so they
+		// are equal
+		return
+	}
+
+	// mark-and-match: for each expected element, find an as-yet-unmatched
+	// actual element equal to it. Matched actual elements are excluded from
+	// consideration for later expected elements, so duplicate counts of an
+	// element are respected (e.g. [1, 1] only matches actual containing two
+	// 1s, not one).
+	matchedActual := make([]bool, aVal.Len())
+	var missing []interface{}
+	for i := 0; i < eVal.Len(); i++ {
+		eItem := eVal.Index(i).Interface()
+
+		found := false
+		for j := 0; j < aVal.Len(); j++ {
+			if matchedActual[j] {
+				continue
+			}
+			aItem := aVal.Index(j).Interface()
+
+			eq, err := checkEqual(eItem, aItem, elemComp)
+			if err != nil {
+				a.fail("comparison for %s failed; expected and actual are not comparable types", a.fullVar(), skipArg{expected}, skipArg{actual})
+			}
+			if eq {
+				matchedActual[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, eItem)
+		}
+	}
+
+	var extra []interface{}
+	for j := 0; j < aVal.Len(); j++ {
+		if !matchedActual[j] {
+			extra = append(extra, aVal.Index(j).Interface())
+		}
+	}
+
+	if len(missing) > 0 || len(extra) > 0 {
+		a.fail("expected %s to have the same elements regardless of order but was missing %v and had extra %v", a.fullVar(), skipArg{expected}, skipArg{actual}, missing, extra)
+	}
+}
+
+// EqualMap checks whether expected and actual are two maps with equal size,
+// same key and value types, and equal contents. It uses the same typed-nil
+// semantics as EqualSlices: both-nil is equal, one-nil is a failure, and
+// differing keys are reported individually.
+func (a Asserter) EqualMap(expected, actual interface{}) {
+	eType := reflect.TypeOf(expected)
+	aType := reflect.TypeOf(actual)
+
+	// assert that both are mappy
+	if eType.Kind() != reflect.Map {
+		a.fail("%s: expected is not a map", a.fullVar(), skipArg{expected}, skipArg{actual})
+		return
+	}
+	if aType.Kind() != reflect.Map {
+		a.fail("expected %s to be %v but actual value was not a map", a.fullVar(), expected, skipArg{actual})
+		return
+	}
+
+	// assert that both are of the same type
+	if eType.Key() != aType.Key() || eType.Elem() != aType.Elem() {
+		a.fail("expected %s to have type %q but was %q", a.fullVar(), eType.String(), aType.String())
+		return
+	}
+
+	var eVal, aVal = reflect.ValueOf(expected), reflect.ValueOf(actual)
+	// Do nil check
+	aIsNil := aVal.IsNil()
+	eIsNil := eVal.IsNil()
+	if aIsNil && !eIsNil {
+		a.fail("expected %s to be %v but was a nil map", a.fullVar(), expected, skipArg{actual})
+	}
+	if !aIsNil && eIsNil {
+		a.fail("expected %s to be a nil map but was %v", a.fullVar(), skipArg{expected}, actual)
+	}
+	if aIsNil && eIsNil {
+		// nothing else to do, they are both nil maps of the same type so they
+		// are equal
+		return
+	}
+
+	var missingKeys []interface{}
+	var extraKeys []interface{}
+	var mismatchedKeys []interface{}
+
+	iter := eVal.MapRange()
+	for iter.Next() {
+		key := iter.Key()
+		eItem := iter.Value().Interface()
+
+		aItemVal := aVal.MapIndex(key)
+		if !aItemVal.IsValid() {
+			missingKeys = append(missingKeys, key.Interface())
+			continue
+		}
+
+		eq, err := checkEqual(eItem, aItemVal.Interface(), nil)
+		if err != nil {
+			a.fail("comparison for %s failed; expected and actual are not comparable types", a.fullVar(), skipArg{expected}, skipArg{actual})
+		}
+		if !eq {
+			mismatchedKeys = append(mismatchedKeys, key.Interface())
+		}
+	}
+
+	aIter := aVal.MapRange()
+	for aIter.Next() {
+		key := aIter.Key()
+		if !eVal.MapIndex(key).IsValid() {
+			extraKeys = append(extraKeys, key.Interface())
+		}
+	}
+
+	if len(missingKeys) > 0 || len(extraKeys) > 0 || len(mismatchedKeys) > 0 {
+		a.fail("expected %s to equal map but was missing keys %v, had extra keys %v, and had mismatched values at keys %v", a.fullVar(), skipArg{expected}, skipArg{actual}, missingKeys, extraKeys, mismatchedKeys)
+	}
+}
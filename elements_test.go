@@ -0,0 +1,74 @@
+package assertion
+
+import "testing"
+
+func TestEqualElements(t *testing.T) {
+	testCases := []struct {
+		name       string
+		expected   interface{}
+		actual     interface{}
+		expectFail bool
+	}{
+		{"same order", []int{1, 2, 3}, []int{1, 2, 3}, false},
+		{"different order", []int{1, 2, 3}, []int{3, 1, 2}, false},
+		{"missing element", []int{1, 2, 3}, []int{1, 2}, true},
+		{"extra element", []int{1, 2}, []int{1, 2, 3}, true},
+		{"duplicate counts are respected", []int{1, 1, 2}, []int{1, 2, 2}, true},
+		{"both nil", []int(nil), []int(nil), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			failed, _ := captureFailure(func(a *Asserter) {
+				a.EqualElements(tc.expected, tc.actual)
+			})
+			if failed != tc.expectFail {
+				t.Fatalf("expected failed=%v but was %v", tc.expectFail, failed)
+			}
+		})
+	}
+}
+
+func TestEqualElements_KindMismatchFailsCleanly(t *testing.T) {
+	failed, _ := captureFailure(func(a *Asserter) {
+		a.EqualElements([]int{1, 2}, "not a slice")
+	})
+	if !failed {
+		t.Fatal("expected EqualElements to fail (not panic) for a non-slice actual")
+	}
+}
+
+func TestEqualMap(t *testing.T) {
+	testCases := []struct {
+		name       string
+		expected   interface{}
+		actual     interface{}
+		expectFail bool
+	}{
+		{"equal maps", map[string]int{"a": 1, "b": 2}, map[string]int{"b": 2, "a": 1}, false},
+		{"both nil", map[string]int(nil), map[string]int(nil), false},
+		{"missing key", map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1}, true},
+		{"extra key", map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2}, true},
+		{"mismatched value", map[string]int{"a": 1}, map[string]int{"a": 2}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			failed, _ := captureFailure(func(a *Asserter) {
+				a.EqualMap(tc.expected, tc.actual)
+			})
+			if failed != tc.expectFail {
+				t.Fatalf("expected failed=%v but was %v", tc.expectFail, failed)
+			}
+		})
+	}
+}
+
+func TestEqualMap_KindMismatchFailsCleanly(t *testing.T) {
+	failed, _ := captureFailure(func(a *Asserter) {
+		a.EqualMap(map[string]int{"a": 1}, []int{1, 2, 3})
+	})
+	if !failed {
+		t.Fatal("expected EqualMap to fail (not panic) for a non-map actual")
+	}
+}
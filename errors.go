@@ -0,0 +1,75 @@
+package assertion
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ErrorIs checks that actual matches target somewhere in its error chain, as
+// determined by errors.Is.
+func (a Asserter) ErrorIs(target, actual error) {
+	if !errors.Is(actual, target) {
+		a.fail("expected %s to match target error %q somewhere in its chain but chain was:\n%s", a.fullVar(), skipArg{target}, skipArg{actual}, target, errorChain(actual))
+	}
+}
+
+// ErrorAs checks that some error in actual's chain can be assigned to target,
+// as determined by errors.As. target must be a non-nil pointer to a type
+// implementing error or to an interface type.
+func (a Asserter) ErrorAs(target interface{}, actual error) {
+	val := reflect.ValueOf(target)
+	if target == nil || val.Kind() != reflect.Ptr || val.IsNil() {
+		a.fail("%s: target passed to ErrorAs must be a non-nil pointer", a.fullVar(), skipArg{target}, skipArg{actual})
+		return
+	}
+
+	if !errors.As(actual, target) {
+		a.fail("expected %s to contain an error matching target type %T somewhere in its chain but chain was:\n%s", a.fullVar(), skipArg{target}, skipArg{actual}, target, errorChain(actual))
+	}
+}
+
+// ErrorContains checks that substr appears somewhere in actual's message, or
+// in the message of any error in its chain.
+func (a Asserter) ErrorContains(substr string, actual error) {
+	for err := actual; err != nil; err = errors.Unwrap(err) {
+		if strings.Contains(err.Error(), substr) {
+			return
+		}
+	}
+	a.fail("expected %s to contain %q somewhere in its chain but chain was:\n%s", a.fullVar(), skipArg{substr}, skipArg{actual}, substr, errorChain(actual))
+}
+
+// NoError checks that actual is nil.
+func (a Asserter) NoError(actual error) {
+	if actual != nil {
+		a.fail("expected %s to be nil but chain was:\n%s", a.fullVar(), skipArg{nil}, skipArg{actual}, errorChain(actual))
+	}
+}
+
+// Error checks that actual is non-nil.
+func (a Asserter) Error(actual error) {
+	if actual == nil {
+		a.fail("expected %s to be a non-nil error but it was nil", a.fullVar(), skipArg{nil}, skipArg{nil})
+	}
+}
+
+// errorChain walks err via errors.Unwrap and returns a multi-line report,
+// one line per layer, so a failure message shows exactly which errors were
+// encountered.
+func errorChain(err error) string {
+	if err == nil {
+		return "  <nil>"
+	}
+
+	var sb strings.Builder
+	for layer := 0; err != nil; layer++ {
+		if layer > 0 {
+			sb.WriteString("\n")
+		}
+		fmt.Fprintf(&sb, "  [%d] %s", layer, err.Error())
+		err = errors.Unwrap(err)
+	}
+	return sb.String()
+}
@@ -0,0 +1,134 @@
+package assertion
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestErrorIs(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	wrapped := fmt.Errorf("wrap: %w", sentinel)
+	other := errors.New("other")
+
+	testCases := []struct {
+		name       string
+		target     error
+		actual     error
+		expectFail bool
+	}{
+		{"direct match", sentinel, sentinel, false},
+		{"wrapped match", sentinel, wrapped, false},
+		{"no match", sentinel, other, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			failed, _ := captureFailure(func(a *Asserter) {
+				a.ErrorIs(tc.target, tc.actual)
+			})
+			if failed != tc.expectFail {
+				t.Fatalf("expected failed=%v but was %v", tc.expectFail, failed)
+			}
+		})
+	}
+}
+
+type errorsTestError struct{ msg string }
+
+func (e *errorsTestError) Error() string { return e.msg }
+
+func TestErrorAs(t *testing.T) {
+	wrapped := fmt.Errorf("wrap: %w", &errorsTestError{"boom"})
+
+	t.Run("matches a wrapped type", func(t *testing.T) {
+		var target *errorsTestError
+		failed, _ := captureFailure(func(a *Asserter) {
+			a.ErrorAs(&target, wrapped)
+		})
+		if failed {
+			t.Fatal("expected ErrorAs to pass for a matching wrapped type")
+		}
+	})
+
+	t.Run("fails when nothing in the chain matches", func(t *testing.T) {
+		var target *errorsTestError
+		failed, _ := captureFailure(func(a *Asserter) {
+			a.ErrorAs(&target, errors.New("plain"))
+		})
+		if !failed {
+			t.Fatal("expected ErrorAs to fail when no error in the chain matches")
+		}
+	})
+
+	t.Run("fails cleanly for a non-pointer target", func(t *testing.T) {
+		failed, _ := captureFailure(func(a *Asserter) {
+			a.ErrorAs("not a pointer", wrapped)
+		})
+		if !failed {
+			t.Fatal("expected ErrorAs to fail cleanly for an invalid target")
+		}
+	})
+}
+
+func TestErrorContains(t *testing.T) {
+	err := errors.New("something went wrong: boom")
+
+	t.Run("substring present", func(t *testing.T) {
+		failed, _ := captureFailure(func(a *Asserter) {
+			a.ErrorContains("boom", err)
+		})
+		if failed {
+			t.Fatal("expected ErrorContains to pass")
+		}
+	})
+
+	t.Run("substring absent", func(t *testing.T) {
+		failed, _ := captureFailure(func(a *Asserter) {
+			a.ErrorContains("nope", err)
+		})
+		if !failed {
+			t.Fatal("expected ErrorContains to fail")
+		}
+	})
+}
+
+func TestNoError(t *testing.T) {
+	t.Run("nil passes", func(t *testing.T) {
+		failed, _ := captureFailure(func(a *Asserter) {
+			a.NoError(nil)
+		})
+		if failed {
+			t.Fatal("expected NoError to pass for nil")
+		}
+	})
+
+	t.Run("non-nil fails", func(t *testing.T) {
+		failed, _ := captureFailure(func(a *Asserter) {
+			a.NoError(errors.New("boom"))
+		})
+		if !failed {
+			t.Fatal("expected NoError to fail for a non-nil error")
+		}
+	})
+}
+
+func TestError(t *testing.T) {
+	t.Run("non-nil passes", func(t *testing.T) {
+		failed, _ := captureFailure(func(a *Asserter) {
+			a.Error(errors.New("boom"))
+		})
+		if failed {
+			t.Fatal("expected Error to pass for a non-nil error")
+		}
+	})
+
+	t.Run("nil fails", func(t *testing.T) {
+		failed, _ := captureFailure(func(a *Asserter) {
+			a.Error(nil)
+		})
+		if !failed {
+			t.Fatal("expected Error to fail for nil")
+		}
+	})
+}
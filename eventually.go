@@ -0,0 +1,143 @@
+package assertion
+
+import "time"
+
+// Eventually checks that condition returns true at least once before waitFor
+// elapses. It is polled once immediately and then again every tick until
+// either it returns true (success) or waitFor elapses (failure).
+//
+// This is useful for testing goroutines, subscribers, or retry-based logic
+// where the outcome is not immediate.
+func (a Asserter) Eventually(condition func() bool, waitFor, tick time.Duration) {
+	if !a.validTick(tick) {
+		return
+	}
+	if !pollUntilTrue(condition, waitFor, tick) {
+		a.fail("expected %s to eventually be true within %s but it never was", a.fullOpVar(), skipArg{true}, skipArg{false}, waitFor)
+	}
+}
+
+// Never checks that condition does not return true at any point before
+// waitFor elapses. It is polled once immediately and then again every tick
+// for the entire duration of waitFor; if condition ever returns true, the
+// assertion fails immediately.
+func (a Asserter) Never(condition func() bool, waitFor, tick time.Duration) {
+	if !a.validTick(tick) {
+		return
+	}
+	if !pollUntilTimeout(condition, waitFor, tick) {
+		a.fail("expected %s to never be true within %s but it became true", a.fullOpVar(), skipArg{true}, skipArg{false}, waitFor)
+	}
+}
+
+// EventuallyWithT is like Eventually, but condition is given an inner
+// Asserter to make assertions against instead of returning a bool. An
+// iteration only counts as passing if none of the assertions made against the
+// inner Asserter fail; failures from any iteration other than the last do not
+// affect the outer Asserter (or fail the test) at all.
+func (a Asserter) EventuallyWithT(condition func(t *Asserter), waitFor, tick time.Duration) {
+	if !a.validTick(tick) {
+		return
+	}
+
+	var lastFailure string
+
+	passed := func() bool {
+		var failed bool
+		inner := a
+		inner.failHook = func(msg string) {
+			failed = true
+			lastFailure = msg
+		}
+		condition(&inner)
+		return !failed
+	}
+
+	if !pollUntilTrue(passed, waitFor, tick) {
+		a.fail("expected %s to eventually pass within %s but it never did (last failure: %s)", a.fullOpVar(), skipArg{true}, skipArg{false}, waitFor, lastFailure)
+	}
+}
+
+// NeverWithT is like Never, but condition is given an inner Asserter to make
+// assertions against instead of returning a bool. An iteration counts as
+// passing (and therefore fails the overall assertion) only if none of the
+// assertions made against the inner Asserter fail.
+func (a Asserter) NeverWithT(condition func(t *Asserter), waitFor, tick time.Duration) {
+	if !a.validTick(tick) {
+		return
+	}
+
+	passed := func() bool {
+		var failed bool
+		inner := a
+		inner.failHook = func(msg string) {
+			failed = true
+		}
+		condition(&inner)
+		return !failed
+	}
+
+	if !pollUntilTimeout(passed, waitFor, tick) {
+		a.fail("expected %s to never pass within %s but it did", a.fullOpVar(), skipArg{true}, skipArg{false}, waitFor)
+	}
+}
+
+// validTick reports whether tick is usable as a time.NewTicker interval,
+// failing a (and returning false) if it is not. time.NewTicker panics for a
+// non-positive interval, and a bad tick argument should be reported through
+// fail() like any other assertion misuse rather than crashing the process.
+func (a Asserter) validTick(tick time.Duration) bool {
+	if tick <= 0 {
+		a.fail("%s: tick must be positive but was %s", a.fullOpVar(), skipArg{true}, skipArg{false}, tick)
+		return false
+	}
+	return true
+}
+
+// pollUntilTrue calls condition immediately and then once per tick until it
+// returns true (in which case pollUntilTrue returns true) or waitFor elapses
+// (in which case it returns false).
+func pollUntilTrue(condition func() bool, waitFor, tick time.Duration) bool {
+	timeout := time.After(waitFor)
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	if condition() {
+		return true
+	}
+
+	for {
+		select {
+		case <-timeout:
+			return false
+		case <-ticker.C:
+			if condition() {
+				return true
+			}
+		}
+	}
+}
+
+// pollUntilTimeout calls condition immediately and then once per tick for the
+// entirety of waitFor. It returns false as soon as condition returns true,
+// and returns true if waitFor elapses without condition ever returning true.
+func pollUntilTimeout(condition func() bool, waitFor, tick time.Duration) bool {
+	timeout := time.After(waitFor)
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	if condition() {
+		return false
+	}
+
+	for {
+		select {
+		case <-timeout:
+			return true
+		case <-ticker.C:
+			if condition() {
+				return false
+			}
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package assertion
+
+import (
+	"testing"
+	"time"
+)
+
+// captureFailure runs fn against a fresh Asserter whose failures are routed
+// to failHook instead of a *testing.T, so assertion failures exercised by a
+// test don't themselves fail the test process.
+func captureFailure(fn func(a *Asserter)) (failed bool, msg string) {
+	a := New(nil)
+	a.failHook = func(m string) {
+		failed = true
+		msg = m
+	}
+	fn(a)
+	return failed, msg
+}
+
+func TestEventually(t *testing.T) {
+	t.Run("passes once condition becomes true in time", func(t *testing.T) {
+		count := 0
+		failed, _ := captureFailure(func(a *Asserter) {
+			a.Eventually(func() bool {
+				count++
+				return count >= 3
+			}, 200*time.Millisecond, 5*time.Millisecond)
+		})
+		if failed {
+			t.Fatal("expected Eventually to pass once condition became true")
+		}
+	})
+
+	t.Run("fails when condition never becomes true", func(t *testing.T) {
+		failed, _ := captureFailure(func(a *Asserter) {
+			a.Eventually(func() bool { return false }, 30*time.Millisecond, 5*time.Millisecond)
+		})
+		if !failed {
+			t.Fatal("expected Eventually to fail when condition is never true")
+		}
+	})
+
+	t.Run("non-positive tick fails cleanly instead of panicking", func(t *testing.T) {
+		failed, _ := captureFailure(func(a *Asserter) {
+			a.Eventually(func() bool { return true }, 50*time.Millisecond, 0)
+		})
+		if !failed {
+			t.Fatal("expected Eventually to fail for a non-positive tick")
+		}
+	})
+}
+
+func TestNever(t *testing.T) {
+	t.Run("passes when condition never becomes true", func(t *testing.T) {
+		failed, _ := captureFailure(func(a *Asserter) {
+			a.Never(func() bool { return false }, 30*time.Millisecond, 5*time.Millisecond)
+		})
+		if failed {
+			t.Fatal("expected Never to pass when condition never becomes true")
+		}
+	})
+
+	t.Run("fails as soon as condition becomes true", func(t *testing.T) {
+		failed, _ := captureFailure(func(a *Asserter) {
+			a.Never(func() bool { return true }, 30*time.Millisecond, 5*time.Millisecond)
+		})
+		if !failed {
+			t.Fatal("expected Never to fail once condition becomes true")
+		}
+	})
+
+	t.Run("non-positive tick fails cleanly instead of panicking", func(t *testing.T) {
+		failed, _ := captureFailure(func(a *Asserter) {
+			a.Never(func() bool { return false }, 50*time.Millisecond, -1)
+		})
+		if !failed {
+			t.Fatal("expected Never to fail for a non-positive tick")
+		}
+	})
+}
+
+func TestEventuallyWithT(t *testing.T) {
+	t.Run("only the final iteration's assertions count", func(t *testing.T) {
+		count := 0
+		failed, _ := captureFailure(func(a *Asserter) {
+			a.EventuallyWithT(func(inner *Asserter) {
+				count++
+				inner.Equal(3, count)
+			}, 200*time.Millisecond, 5*time.Millisecond)
+		})
+		if failed {
+			t.Fatal("expected EventuallyWithT to pass once the inner assertions pass")
+		}
+	})
+
+	t.Run("non-positive tick fails cleanly instead of panicking", func(t *testing.T) {
+		failed, _ := captureFailure(func(a *Asserter) {
+			a.EventuallyWithT(func(inner *Asserter) {
+				inner.Equal(1, 1)
+			}, 50*time.Millisecond, 0)
+		})
+		if !failed {
+			t.Fatal("expected EventuallyWithT to fail for a non-positive tick")
+		}
+	})
+}
+
+func TestNeverWithT(t *testing.T) {
+	t.Run("fails once the inner assertions eventually all pass", func(t *testing.T) {
+		count := 0
+		failed, _ := captureFailure(func(a *Asserter) {
+			a.NeverWithT(func(inner *Asserter) {
+				count++
+				inner.Equal(3, count)
+			}, 200*time.Millisecond, 5*time.Millisecond)
+		})
+		if !failed {
+			t.Fatal("expected NeverWithT to fail once the inner assertions eventually all pass")
+		}
+	})
+}
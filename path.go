@@ -0,0 +1,274 @@
+package assertion
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// EqualAt evaluates path against actual (a struct/map/slice tree) and checks
+// that the selected sub-value is equal to expected.
+//
+// path uses a compact subset of JMESPath grammar:
+//
+//     foo.bar.baz     dotted identifiers select map keys or exported struct
+//                     fields
+//     users[2].name   bracket indexing selects into a slice or array
+//     users[*].name   a bracket wildcard projects into every element of a
+//                     slice or array; expected is then compared against a
+//                     slice of the per-element results, in order
+//     m["weird key"]  a quoted bracket key selects a map key that itself
+//                     contains dots or other special characters
+//
+// This makes it practical to assert one field deep inside a large decoded
+// JSON/config object without constructing a mirror struct.
+func (a Asserter) EqualAt(path string, expected interface{}, actual interface{}) {
+	segs, err := parsePath(path)
+	if err != nil {
+		a.fail("%s: %v", a.fullVar(), skipArg{expected}, skipArg{actual}, err)
+		return
+	}
+
+	resolved, err := evalPath(reflect.ValueOf(actual), segs)
+	if err != nil {
+		if pe, ok := err.(*PathError); ok {
+			pe.Path = path
+		}
+		a.fail("%s: %v", a.fullVar(), skipArg{expected}, skipArg{actual}, err)
+		return
+	}
+
+	subA := a
+	subA.VarNamePrefix = a.fullVar()
+	if len(path) > 0 && path[0] == '[' {
+		subA.VarName = path
+	} else {
+		subA.VarName = "." + path
+	}
+
+	if hasWildcard(segs) {
+		projected, ok := resolved.([]interface{})
+		if !ok {
+			subA.fail("%s: path contains a wildcard but did not produce a slice of results", subA.fullVar(), skipArg{expected}, skipArg{actual})
+			return
+		}
+
+		expVal := reflect.ValueOf(expected)
+		if expVal.Kind() != reflect.Slice && expVal.Kind() != reflect.Array {
+			subA.fail("expected %s to be a slice to compare against a wildcard projection but was %T", subA.fullVar(), expected, skipArg{actual})
+			return
+		}
+		if expVal.Len() != len(projected) {
+			subA.fail("expected %s to have len of %d but wildcard projection produced %d", subA.fullVar(), expVal.Len(), len(projected))
+			return
+		}
+
+		for i := 0; i < expVal.Len(); i++ {
+			elemA := subA
+			elemA.VarNamePrefix = subA.fullVar()
+			elemA.VarName = fmt.Sprintf("[%d]", i)
+			elemA.Equal(expVal.Index(i).Interface(), projected[i])
+		}
+		return
+	}
+
+	subA.Equal(expected, resolved)
+}
+
+// PathError is returned when a path passed to EqualAt cannot be parsed, or
+// cannot be resolved against the actual value it is evaluated against.
+type PathError struct {
+	// Path is the full path that was being evaluated.
+	Path string
+
+	// Segment is the specific segment of Path where resolution failed.
+	Segment string
+
+	// Err is the underlying cause.
+	Err error
+}
+
+func (e *PathError) Error() string {
+	return fmt.Sprintf("path %q: at %q: %v", e.Path, e.Segment, e.Err)
+}
+
+func (e *PathError) Unwrap() error {
+	return e.Err
+}
+
+// pathSegKind identifies the kind of a single step in a parsed path.
+type pathSegKind int
+
+const (
+	pathSegField pathSegKind = iota
+	pathSegKey
+	pathSegIndex
+	pathSegWildcard
+)
+
+// pathSegment is a single step of a parsed EqualAt path.
+type pathSegment struct {
+	kind  pathSegKind
+	name  string // set for pathSegField and pathSegKey
+	index int    // set for pathSegIndex
+	text  string // original source text of the segment, for error messages
+}
+
+// hasWildcard returns whether any segment of segs is a wildcard projection.
+func hasWildcard(segs []pathSegment) bool {
+	for _, s := range segs {
+		if s.kind == pathSegWildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// parsePath parses a dotted/bracketed JMESPath-style selector into a series
+// of path segments.
+func parsePath(path string) ([]pathSegment, error) {
+	var segs []pathSegment
+
+	i := 0
+	n := len(path)
+	for i < n {
+		switch {
+		case path[i] == '.':
+			i++
+		case path[i] == '[' && i+1 < n && path[i+1] == '"':
+			// quoted key: scan for the closing quote rather than the next
+			// ']', since the key itself may contain a literal ']'.
+			closeQuote := strings.IndexByte(path[i+2:], '"')
+			if closeQuote < 0 {
+				return nil, &PathError{Path: path, Segment: path[i:], Err: fmt.Errorf("unterminated quoted key")}
+			}
+			closeQuote += i + 2
+			if closeQuote+1 >= n || path[closeQuote+1] != ']' {
+				return nil, &PathError{Path: path, Segment: path[i : closeQuote+1], Err: fmt.Errorf("expected ']' after quoted key")}
+			}
+
+			segs = append(segs, pathSegment{kind: pathSegKey, name: path[i+2 : closeQuote], text: path[i : closeQuote+2]})
+			i = closeQuote + 2
+		case path[i] == '[':
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, &PathError{Path: path, Segment: path[i:], Err: fmt.Errorf("unterminated '['")}
+			}
+			inner := strings.TrimSpace(path[i+1 : i+end])
+			text := path[i : i+end+1]
+			i += end + 1
+
+			switch {
+			case inner == "*":
+				segs = append(segs, pathSegment{kind: pathSegWildcard, text: text})
+			default:
+				idx, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, &PathError{Path: path, Segment: text, Err: fmt.Errorf("%q is not a valid index or wildcard", inner)}
+				}
+				segs = append(segs, pathSegment{kind: pathSegIndex, index: idx, text: text})
+			}
+		default:
+			start := i
+			for i < n && path[i] != '.' && path[i] != '[' {
+				i++
+			}
+			name := path[start:i]
+			if name == "" {
+				return nil, &PathError{Path: path, Segment: path[start:], Err: fmt.Errorf("expected a field name")}
+			}
+			segs = append(segs, pathSegment{kind: pathSegField, name: name, text: name})
+		}
+	}
+
+	if len(segs) == 0 {
+		return nil, &PathError{Path: path, Segment: path, Err: fmt.Errorf("path is empty")}
+	}
+
+	return segs, nil
+}
+
+// evalPath walks val according to segs, dereferencing pointers and
+// interfaces at each step, and returns the selected sub-value (or, if segs
+// contains a wildcard, a []interface{} of the per-element results).
+func evalPath(val reflect.Value, segs []pathSegment) (interface{}, error) {
+	val = derefPathValue(val)
+
+	if len(segs) == 0 {
+		if !val.IsValid() {
+			return nil, nil
+		}
+		return val.Interface(), nil
+	}
+
+	seg := segs[0]
+	rest := segs[1:]
+
+	switch seg.kind {
+	case pathSegField:
+		switch val.Kind() {
+		case reflect.Map:
+			return evalMapKey(val, seg, rest)
+		case reflect.Struct:
+			sf, ok := val.Type().FieldByName(seg.name)
+			if !ok || sf.PkgPath != "" {
+				return nil, &PathError{Segment: seg.text, Err: fmt.Errorf("no exported field %q", seg.name)}
+			}
+			return evalPath(val.FieldByIndex(sf.Index), rest)
+		default:
+			return nil, &PathError{Segment: seg.text, Err: fmt.Errorf("cannot select field %q from a %s", seg.name, val.Kind())}
+		}
+	case pathSegKey:
+		if val.Kind() != reflect.Map {
+			return nil, &PathError{Segment: seg.text, Err: fmt.Errorf("cannot select key %q from a %s", seg.name, val.Kind())}
+		}
+		return evalMapKey(val, seg, rest)
+	case pathSegIndex:
+		if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+			return nil, &PathError{Segment: seg.text, Err: fmt.Errorf("cannot index into a %s", val.Kind())}
+		}
+		if seg.index < 0 || seg.index >= val.Len() {
+			return nil, &PathError{Segment: seg.text, Err: fmt.Errorf("index %d out of range (len %d)", seg.index, val.Len())}
+		}
+		return evalPath(val.Index(seg.index), rest)
+	case pathSegWildcard:
+		if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+			return nil, &PathError{Segment: seg.text, Err: fmt.Errorf("cannot project wildcard into a %s", val.Kind())}
+		}
+		results := make([]interface{}, val.Len())
+		for i := 0; i < val.Len(); i++ {
+			r, err := evalPath(val.Index(i), rest)
+			if err != nil {
+				return nil, err
+			}
+			results[i] = r
+		}
+		return results, nil
+	}
+
+	return nil, &PathError{Segment: seg.text, Err: fmt.Errorf("unknown path segment")}
+}
+
+func evalMapKey(val reflect.Value, seg pathSegment, rest []pathSegment) (interface{}, error) {
+	if val.Type().Key().Kind() != reflect.String {
+		return nil, &PathError{Segment: seg.text, Err: fmt.Errorf("cannot select string key %q from a map with non-string key type %s", seg.name, val.Type().Key())}
+	}
+	mv := val.MapIndex(reflect.ValueOf(seg.name).Convert(val.Type().Key()))
+	if !mv.IsValid() {
+		return nil, &PathError{Segment: seg.text, Err: fmt.Errorf("key %q not found", seg.name)}
+	}
+	return evalPath(mv, rest)
+}
+
+// derefPathValue dereferences pointers and interfaces, stopping at the first
+// nil it encounters.
+func derefPathValue(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return v
+		}
+		v = v.Elem()
+	}
+	return v
+}
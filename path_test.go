@@ -0,0 +1,72 @@
+package assertion
+
+import "testing"
+
+func TestEqualAt(t *testing.T) {
+	type address struct {
+		City string
+	}
+	type user struct {
+		Name    string
+		Address address
+		Tags    []string
+	}
+
+	data := map[string]interface{}{
+		"users": []user{
+			{Name: "alice", Address: address{City: "nyc"}, Tags: []string{"a", "b"}},
+			{Name: "bob", Address: address{City: "sf"}, Tags: []string{"c"}},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		path       string
+		expected   interface{}
+		expectFail bool
+	}{
+		{"struct field through slice index", "users[0].Name", "alice", false},
+		{"nested struct field", "users[1].Address.City", "sf", false},
+		{"wildcard projection", "users[*].Name", []string{"alice", "bob"}, false},
+		{"mismatch", "users[0].Name", "bob", true},
+		{"missing map key", "nope", "anything", true},
+		{"index out of range", "users[5].Name", "alice", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			failed, _ := captureFailure(func(a *Asserter) {
+				a.EqualAt(tc.path, tc.expected, data)
+			})
+			if failed != tc.expectFail {
+				t.Fatalf("expected failed=%v but was %v", tc.expectFail, failed)
+			}
+		})
+	}
+}
+
+func TestEqualAt_QuotedKey(t *testing.T) {
+	data := map[string]interface{}{
+		"weird key": "value",
+	}
+
+	failed, _ := captureFailure(func(a *Asserter) {
+		a.EqualAt(`["weird key"]`, "value", data)
+	})
+	if failed {
+		t.Fatal("expected EqualAt to resolve a quoted map key")
+	}
+}
+
+func TestEqualAt_QuotedKeyContainingLiteralBracket(t *testing.T) {
+	data := map[string]interface{}{
+		"a]b": "value",
+	}
+
+	failed, _ := captureFailure(func(a *Asserter) {
+		a.EqualAt(`["a]b"]`, "value", data)
+	})
+	if failed {
+		t.Fatal("expected EqualAt to resolve a quoted key containing a literal ']'")
+	}
+}
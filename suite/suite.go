@@ -0,0 +1,119 @@
+// Package suite provides xUnit-style grouping of tests, with optional
+// Setup/TearDown lifecycle hooks and a shared Asserter that is reset before
+// each subtest, without pulling in testify.
+package suite
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dekarrin/assertion"
+)
+
+// Suite is embedded by a user's test type to give it an Asserter that Run
+// keeps pointed at the correct *testing.T, plus access to any of the
+// SetupAllSuite, TearDownAllSuite, SetupTestSuite, and TearDownTestSuite
+// hooks it chooses to implement.
+//
+// Because the embedded Asserter is shared state that Run resets before every
+// subtest, subtests discovered by Run must not call t.Parallel(): doing so
+// would race with the reset for the next subtest (or with that subtest's own
+// failures) and is not supported.
+type Suite struct {
+	*assertion.Asserter
+}
+
+// SetAsserter sets the Asserter that s will use. It is called by Run and is
+// not usually called directly.
+func (s *Suite) SetAsserter(a *assertion.Asserter) {
+	s.Asserter = a
+}
+
+// GetAsserter returns the Asserter currently set on s, or nil if none has
+// been set yet.
+func (s *Suite) GetAsserter() *assertion.Asserter {
+	return s.Asserter
+}
+
+// TestingSuite is the minimal interface Run needs in order to manage a
+// suite's Asserter. Embedding Suite satisfies it.
+type TestingSuite interface {
+	SetAsserter(a *assertion.Asserter)
+	GetAsserter() *assertion.Asserter
+}
+
+// SetupAllSuite is implemented by suites that need to run setup once, before
+// any of their subtests.
+type SetupAllSuite interface {
+	SetupSuite()
+}
+
+// TearDownAllSuite is implemented by suites that need to run teardown once,
+// after all of their subtests have finished.
+type TearDownAllSuite interface {
+	TearDownSuite()
+}
+
+// SetupTestSuite is implemented by suites that need to run setup before each
+// subtest.
+type SetupTestSuite interface {
+	SetupTest()
+}
+
+// TearDownTestSuite is implemented by suites that need to run teardown after
+// each subtest.
+type TearDownTestSuite interface {
+	TearDownTest()
+}
+
+// Run discovers every exported method of s named TestXxx with the signature
+// func(t *testing.T) and runs each as a subtest of t via t.Run, in the order
+// reflection reports them.
+//
+// Before each subtest, Run resets s's Asserter to that subtest's *testing.T,
+// so failures made against it are reported against the right test. SetupTest
+// and TearDownTest, if implemented by s, run immediately before and after
+// each subtest; SetupSuite and TearDownSuite, if implemented, run once before
+// and after the entire set of subtests.
+func Run(t *testing.T, s TestingSuite) {
+	if s.GetAsserter() == nil {
+		s.SetAsserter(assertion.New(t))
+	} else {
+		s.GetAsserter().Reset(t)
+	}
+
+	if setupAll, ok := s.(SetupAllSuite); ok {
+		setupAll.SetupSuite()
+	}
+	if tearDownAll, ok := s.(TearDownAllSuite); ok {
+		defer tearDownAll.TearDownSuite()
+	}
+
+	suiteType := reflect.TypeOf(s)
+	suiteVal := reflect.ValueOf(s)
+	tType := reflect.TypeOf((*testing.T)(nil))
+
+	for i := 0; i < suiteType.NumMethod(); i++ {
+		method := suiteType.Method(i)
+		if !strings.HasPrefix(method.Name, "Test") {
+			continue
+		}
+		if method.Func.Type().NumIn() != 2 || method.Func.Type().In(1) != tType {
+			continue
+		}
+
+		t.Run(method.Name, func(subT *testing.T) {
+			s.GetAsserter().Reset(subT)
+
+			if setupTest, ok := s.(SetupTestSuite); ok {
+				setupTest.SetupTest()
+			}
+			if tearDownTest, ok := s.(TearDownTestSuite); ok {
+				defer tearDownTest.TearDownTest()
+			}
+
+			suiteVal.Method(i).Call([]reflect.Value{reflect.ValueOf(subT)})
+		})
+	}
+}
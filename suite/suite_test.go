@@ -0,0 +1,55 @@
+package suite
+
+import "testing"
+
+type exampleSuite struct {
+	Suite
+
+	setupSuiteCalled    bool
+	tearDownSuiteCalled bool
+	setupTestCalls      int
+	tearDownTestCalls   int
+	testCalls           []string
+}
+
+func (s *exampleSuite) SetupSuite()    { s.setupSuiteCalled = true }
+func (s *exampleSuite) TearDownSuite() { s.tearDownSuiteCalled = true }
+func (s *exampleSuite) SetupTest()     { s.setupTestCalls++ }
+func (s *exampleSuite) TearDownTest()  { s.tearDownTestCalls++ }
+
+func (s *exampleSuite) TestOne(t *testing.T) {
+	s.testCalls = append(s.testCalls, "TestOne")
+	s.Equal(1, 1)
+}
+
+func (s *exampleSuite) TestTwo(t *testing.T) {
+	s.testCalls = append(s.testCalls, "TestTwo")
+	s.Equal(2, 2)
+}
+
+// NotATest does not have a name starting with "Test" (nor a *testing.T
+// parameter) and so must not be discovered as a test method by Run.
+func (s *exampleSuite) NotATest() {
+	s.testCalls = append(s.testCalls, "NotATest")
+}
+
+func TestRun(t *testing.T) {
+	s := &exampleSuite{}
+	Run(t, s)
+
+	if !s.setupSuiteCalled {
+		t.Error("expected SetupSuite to be called")
+	}
+	if !s.tearDownSuiteCalled {
+		t.Error("expected TearDownSuite to be called")
+	}
+	if s.setupTestCalls != 2 {
+		t.Errorf("expected SetupTest to be called twice but was called %d times", s.setupTestCalls)
+	}
+	if s.tearDownTestCalls != 2 {
+		t.Errorf("expected TearDownTest to be called twice but was called %d times", s.tearDownTestCalls)
+	}
+	if len(s.testCalls) != 2 {
+		t.Errorf("expected exactly the 2 TestXxx methods to run but got %v", s.testCalls)
+	}
+}